@@ -0,0 +1,129 @@
+package aggregator
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+)
+
+var errNoFreshQuotes = errors.New("aggregator: no fresh quotes available")
+
+// Quote is a single price observation from an exchange.
+type Quote struct {
+	Price     float64
+	Volume    float64
+	Timestamp time.Time
+}
+
+// ExchangePriceFetcher fetches the price of a base/quote pair from a single exchange.
+type ExchangePriceFetcher interface {
+	FetchPrice(base, quote string) (float64, error)
+}
+
+// ExchangeQuoteFetcher is an optional extension of ExchangePriceFetcher for
+// exchanges that can also report trade volume and the quote's timestamp,
+// needed by the VolumeWeighted strategy and TTL-based staleness filtering.
+// Exchanges that only implement ExchangePriceFetcher are quoted with the
+// current time and zero volume.
+type ExchangeQuoteFetcher interface {
+	FetchQuote(base, quote string) (Quote, error)
+}
+
+// ExchangeAggregator aggregates price quotes across multiple exchanges using a
+// pluggable PriceAggregationStrategy.
+type ExchangeAggregator struct {
+	config    config.ExchangeConfig
+	exchanges []ExchangePriceFetcher
+	strategy  PriceAggregationStrategy
+}
+
+// NewExchangeAggregator wires up the default set of exchange fetchers and the
+// aggregation strategy selected by cfg.Strategy.
+func NewExchangeAggregator(cfg config.ExchangeConfig) *ExchangeAggregator {
+	return &ExchangeAggregator{
+		config: cfg,
+		exchanges: []ExchangePriceFetcher{
+			Cryptocom{},
+		},
+		strategy: strategyFor(cfg),
+	}
+}
+
+func strategyFor(cfg config.ExchangeConfig) PriceAggregationStrategy {
+	switch cfg.Strategy {
+	case config.StrategyMedian:
+		return Median{}
+	case config.StrategyTrimmedMean:
+		return TrimmedMean{TrimFraction: cfg.TrimFraction}
+	case config.StrategyVolumeWeighted:
+		return VolumeWeighted{}
+	default:
+		return SimpleMean{}
+	}
+}
+
+// FetchPrice queries every configured exchange concurrently and combines the
+// resulting quotes with the configured PriceAggregationStrategy, dropping
+// quotes older than config.ExchangeConfig.QuoteTTL.
+func (e *ExchangeAggregator) FetchPrice(base, quote string) (float64, error) {
+	quotes := filterStale(e.fetchQuotes(base, quote), e.config.QuoteTTL)
+	if len(quotes) == 0 {
+		return 0, errNoFreshQuotes
+	}
+	return e.strategy.Aggregate(quotes)
+}
+
+func (e *ExchangeAggregator) fetchQuotes(base, quote string) []Quote {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		quotes []Quote
+	)
+
+	for _, exchange := range e.exchanges {
+		wg.Add(1)
+		go func(exchange ExchangePriceFetcher) {
+			defer wg.Done()
+			fetched, err := fetchQuote(exchange, base, quote)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			quotes = append(quotes, fetched)
+			mu.Unlock()
+		}(exchange)
+	}
+
+	wg.Wait()
+	return quotes
+}
+
+func fetchQuote(exchange ExchangePriceFetcher, base, quote string) (Quote, error) {
+	if quoteFetcher, ok := exchange.(ExchangeQuoteFetcher); ok {
+		return quoteFetcher.FetchQuote(base, quote)
+	}
+
+	price, err := exchange.FetchPrice(base, quote)
+	if err != nil {
+		return Quote{}, err
+	}
+	return Quote{Price: price, Timestamp: time.Now()}, nil
+}
+
+func filterStale(quotes []Quote, ttl time.Duration) []Quote {
+	if ttl <= 0 {
+		return quotes
+	}
+
+	fresh := make([]Quote, 0, len(quotes))
+	cutoff := time.Now().Add(-ttl)
+	for _, q := range quotes {
+		if q.Timestamp.Before(cutoff) {
+			continue
+		}
+		fresh = append(fresh, q)
+	}
+	return fresh
+}
@@ -0,0 +1,78 @@
+package aggregator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/stretchr/testify/require"
+)
+
+// mockExchange injects synthetic quotes into the aggregator for a fixed
+// base/quote pair, standing in for a real exchange in tests.
+type mockExchange struct {
+	quote Quote
+	err   error
+}
+
+func (m mockExchange) FetchPrice(base, quote string) (float64, error) {
+	return m.quote.Price, m.err
+}
+
+func (m mockExchange) FetchQuote(base, quote string) (Quote, error) {
+	if m.err != nil {
+		return Quote{}, m.err
+	}
+	return m.quote, nil
+}
+
+func TestExchangeAggregator_FetchPriceUsesConfiguredStrategy(t *testing.T) {
+	t.Parallel()
+
+	aggregator := &ExchangeAggregator{
+		config: config.ExchangeConfig{Strategy: config.StrategyMedian},
+		exchanges: []ExchangePriceFetcher{
+			mockExchange{quote: Quote{Price: 10, Timestamp: time.Now()}},
+			mockExchange{quote: Quote{Price: 11, Timestamp: time.Now()}},
+			mockExchange{quote: Quote{Price: 1000, Timestamp: time.Now()}},
+		},
+		strategy: Median{},
+	}
+
+	price, err := aggregator.FetchPrice("ETH", "USD")
+	require.Nil(t, err)
+	require.InDelta(t, 11, price, 0.0001)
+}
+
+func TestExchangeAggregator_FetchPriceFiltersStaleQuotes(t *testing.T) {
+	t.Parallel()
+
+	aggregator := &ExchangeAggregator{
+		config: config.ExchangeConfig{Strategy: config.StrategyMean, QuoteTTL: time.Minute},
+		exchanges: []ExchangePriceFetcher{
+			mockExchange{quote: Quote{Price: 10, Timestamp: time.Now()}},
+			mockExchange{quote: Quote{Price: 1000, Timestamp: time.Now().Add(-time.Hour)}},
+		},
+		strategy: SimpleMean{},
+	}
+
+	price, err := aggregator.FetchPrice("ETH", "USD")
+	require.Nil(t, err)
+	require.InDelta(t, 10, price, 0.0001)
+}
+
+func TestExchangeAggregator_FetchPriceErrorsWhenAllExchangesFail(t *testing.T) {
+	t.Parallel()
+
+	aggregator := &ExchangeAggregator{
+		config: config.ExchangeConfig{},
+		exchanges: []ExchangePriceFetcher{
+			mockExchange{err: errors.New("boom")},
+		},
+		strategy: SimpleMean{},
+	}
+
+	_, err := aggregator.FetchPrice("ETH", "USD")
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package aggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const cryptocomTickerURL = "https://api.crypto.com/v2/public/get-ticker?instrument_name=%s_%s"
+
+// Cryptocom fetches spot prices from the crypto.com public ticker endpoint.
+type Cryptocom struct{}
+
+type cryptocomResponse struct {
+	Result struct {
+		Data struct {
+			LatestTrade string `json:"a"`
+			Volume      string `json:"v"`
+			TimestampMs int64  `json:"t"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// FetchPrice returns the latest traded price for the given base/quote pair.
+func (c Cryptocom) FetchPrice(base, quote string) (float64, error) {
+	q, err := c.FetchQuote(base, quote)
+	if err != nil {
+		return -1, err
+	}
+	return q.Price, nil
+}
+
+// FetchQuote returns the latest traded price, 24h volume, and observation
+// timestamp for the given base/quote pair.
+func (c Cryptocom) FetchQuote(base, quote string) (Quote, error) {
+	resp, err := http.Get(fmt.Sprintf(cryptocomTickerURL, base, quote))
+	if err != nil {
+		return Quote{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Quote{}, fmt.Errorf("cryptocom: unexpected status code %d", resp.StatusCode)
+	}
+
+	var parsed cryptocomResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return Quote{}, err
+	}
+
+	price, err := strconv.ParseFloat(parsed.Result.Data.LatestTrade, 64)
+	if err != nil {
+		return Quote{}, fmt.Errorf("cryptocom: could not parse price for %s_%s: %w", base, quote, err)
+	}
+
+	volume, err := strconv.ParseFloat(parsed.Result.Data.Volume, 64)
+	if err != nil {
+		volume = 0
+	}
+
+	timestamp := time.Now()
+	if parsed.Result.Data.TimestampMs > 0 {
+		timestamp = time.UnixMilli(parsed.Result.Data.TimestampMs)
+	}
+
+	return Quote{Price: price, Volume: volume, Timestamp: timestamp}, nil
+}
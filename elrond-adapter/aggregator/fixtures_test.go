@@ -0,0 +1,7 @@
+package aggregator
+
+const (
+	okBaseTicker  = "BTC"
+	errBaseTicker = "NOTATICKER"
+	USDQuote      = "USD"
+)
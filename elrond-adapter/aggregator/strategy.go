@@ -0,0 +1,107 @@
+package aggregator
+
+import (
+	"errors"
+	"sort"
+)
+
+var (
+	errNoQuotes            = errors.New("aggregator: cannot aggregate zero quotes")
+	errInvalidTrimFraction = errors.New("aggregator: TrimFraction must be in [0, 0.5)")
+)
+
+// PriceAggregationStrategy combines a set of exchange quotes into a single
+// price.
+type PriceAggregationStrategy interface {
+	Aggregate(quotes []Quote) (float64, error)
+}
+
+// SimpleMean averages every quote's price with equal weight.
+type SimpleMean struct{}
+
+func (SimpleMean) Aggregate(quotes []Quote) (float64, error) {
+	if len(quotes) == 0 {
+		return 0, errNoQuotes
+	}
+	return mean(pricesOf(quotes)), nil
+}
+
+// Median takes the middle price, averaging the two middle values for an even
+// number of quotes. More resilient to a single wild outlier than SimpleMean.
+type Median struct{}
+
+func (Median) Aggregate(quotes []Quote) (float64, error) {
+	if len(quotes) == 0 {
+		return 0, errNoQuotes
+	}
+	prices := pricesOf(quotes)
+	sort.Float64s(prices)
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return (prices[mid-1] + prices[mid]) / 2, nil
+	}
+	return prices[mid], nil
+}
+
+// TrimmedMean drops the top and bottom TrimFraction of prices before
+// averaging the remainder, e.g. TrimFraction 0.1 drops the lowest and highest
+// 10% of quotes.
+type TrimmedMean struct {
+	TrimFraction float64
+}
+
+func (t TrimmedMean) Aggregate(quotes []Quote) (float64, error) {
+	if len(quotes) == 0 {
+		return 0, errNoQuotes
+	}
+	if t.TrimFraction < 0 || t.TrimFraction >= 0.5 {
+		return 0, errInvalidTrimFraction
+	}
+
+	prices := pricesOf(quotes)
+	sort.Float64s(prices)
+
+	trim := int(float64(len(prices)) * t.TrimFraction)
+	trimmed := prices[trim : len(prices)-trim]
+	if len(trimmed) == 0 {
+		trimmed = prices
+	}
+	return mean(trimmed), nil
+}
+
+// VolumeWeighted computes the volume-weighted average price (VWAP) across
+// quotes. Falls back to SimpleMean when no quote reports any volume.
+type VolumeWeighted struct{}
+
+func (VolumeWeighted) Aggregate(quotes []Quote) (float64, error) {
+	if len(quotes) == 0 {
+		return 0, errNoQuotes
+	}
+
+	var totalVolume, weightedSum float64
+	for _, q := range quotes {
+		totalVolume += q.Volume
+		weightedSum += q.Price * q.Volume
+	}
+	if totalVolume == 0 {
+		return mean(pricesOf(quotes)), nil
+	}
+	return weightedSum / totalVolume, nil
+}
+
+func pricesOf(quotes []Quote) []float64 {
+	prices := make([]float64, len(quotes))
+	for i, q := range quotes {
+		prices[i] = q.Price
+	}
+	return prices
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
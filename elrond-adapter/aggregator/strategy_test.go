@@ -0,0 +1,104 @@
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func quotesAt(prices ...float64) []Quote {
+	quotes := make([]Quote, len(prices))
+	for i, p := range prices {
+		quotes[i] = Quote{Price: p, Timestamp: time.Now()}
+	}
+	return quotes
+}
+
+func TestPriceAggregationStrategy_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		strategy PriceAggregationStrategy
+		quotes   []Quote
+		want     float64
+	}{
+		{
+			name:     "simple mean averages every quote",
+			strategy: SimpleMean{},
+			quotes:   quotesAt(10, 20, 30),
+			want:     20,
+		},
+		{
+			name:     "median ignores a single outlier",
+			strategy: Median{},
+			quotes:   quotesAt(10, 11, 1000),
+			want:     11,
+		},
+		{
+			name:     "trimmed mean drops top and bottom outliers",
+			strategy: TrimmedMean{TrimFraction: 0.2},
+			quotes:   quotesAt(1, 10, 11, 12, 1000),
+			want:     11,
+		},
+		{
+			name:     "volume weighted favours the higher-volume quote",
+			strategy: VolumeWeighted{},
+			quotes: []Quote{
+				{Price: 10, Volume: 1},
+				{Price: 20, Volume: 9},
+			},
+			want: 19,
+		},
+		{
+			name:     "volume weighted falls back to mean with no volume",
+			strategy: VolumeWeighted{},
+			quotes:   quotesAt(10, 20),
+			want:     15,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.strategy.Aggregate(tt.quotes)
+			require.Nil(t, err)
+			require.InDelta(t, tt.want, got, 0.0001)
+		})
+	}
+}
+
+func TestTrimmedMean_AggregateRejectsOutOfRangeTrimFraction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		trimFraction float64
+	}{
+		{name: "trim fraction above 0.5 would empty-slice-panic", trimFraction: 0.6},
+		{name: "trim fraction exactly 0.5 would drop every quote", trimFraction: 0.5},
+		{name: "negative trim fraction is nonsensical", trimFraction: -0.1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			strategy := TrimmedMean{TrimFraction: tt.trimFraction}
+			_, err := strategy.Aggregate(quotesAt(1, 2, 3, 4, 5))
+			require.Equal(t, errInvalidTrimFraction, err)
+		})
+	}
+}
+
+func TestPriceAggregationStrategy_AggregateEmptyQuotesErrors(t *testing.T) {
+	t.Parallel()
+
+	strategies := []PriceAggregationStrategy{SimpleMean{}, Median{}, TrimmedMean{TrimFraction: 0.1}, VolumeWeighted{}}
+	for _, s := range strategies {
+		_, err := s.Aggregate(nil)
+		require.Equal(t, errNoQuotes, err)
+	}
+}
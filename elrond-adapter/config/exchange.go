@@ -0,0 +1,33 @@
+package config
+
+import "time"
+
+const (
+	// StrategyMean averages every exchange quote with equal weight. Default
+	// when Strategy is left unset.
+	StrategyMean = "mean"
+	// StrategyMedian takes the middle quote, resilient to a single outlier.
+	StrategyMedian = "median"
+	// StrategyTrimmedMean drops the top/bottom TrimFraction of quotes before
+	// averaging the remainder.
+	StrategyTrimmedMean = "trimmed_mean"
+	// StrategyVolumeWeighted computes a volume-weighted average price.
+	StrategyVolumeWeighted = "vwap"
+)
+
+// ExchangeConfig holds the configuration for the price exchange aggregator.
+type ExchangeConfig struct {
+	// Strategy selects the PriceAggregationStrategy used to combine quotes
+	// across exchanges. One of StrategyMean, StrategyMedian,
+	// StrategyTrimmedMean or StrategyVolumeWeighted. Defaults to StrategyMean.
+	Strategy string
+
+	// TrimFraction is the fraction of quotes trimmed from each end when
+	// Strategy is StrategyTrimmedMean, e.g. 0.1 drops the lowest and highest
+	// 10% of quotes.
+	TrimFraction float64
+
+	// QuoteTTL drops quotes older than this before aggregating. Zero disables
+	// staleness filtering.
+	QuoteTTL time.Duration
+}
@@ -0,0 +1,10 @@
+package config
+
+// FeePolicyConfig configures the minimum acceptable, asset-denominated gas
+// price for on-chain price submissions.
+type FeePolicyConfig struct {
+	// MinGasPrices maps an asset ticker to the minimum decimal amount of that
+	// asset worth paying in network fees before a submission is deferred,
+	// e.g. {"EGLD": "0.000000001", "ETH": "0.5"}.
+	MinGasPrices map[string]string
+}
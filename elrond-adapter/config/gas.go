@@ -0,0 +1,61 @@
+package config
+
+import "time"
+
+// GasTargetAsset describes a single asset for which gas prices should be denominated.
+type GasTargetAsset struct {
+	Ticker   string
+	Decimals int
+}
+
+// GasProviderConfig configures a single gas price provider queried by the
+// GasOracleAggregator.
+type GasProviderConfig struct {
+	// Name identifies the provider, e.g. "ethgasstation", "etherscan",
+	// "blocknative", "polygon", "jsonrpc".
+	Name string
+
+	// Weight influences how much this provider's quote counts towards the
+	// aggregated median. Defaults to 1 when left unset.
+	Weight float64
+
+	// Timeout bounds how long the aggregator waits for this provider before
+	// excluding it from the current round.
+	Timeout time.Duration
+
+	// APIKey is passed to providers that require authentication.
+	APIKey string
+
+	// Disabled excludes the provider from the aggregator without removing its
+	// config entry. A provider listed in GasConfig.Providers is enabled by
+	// default; set Disabled to true to turn it off temporarily.
+	Disabled bool
+}
+
+// GasConfig holds the configuration for the Ethereum gas price denominator.
+type GasConfig struct {
+	TargetAssets []GasTargetAsset
+
+	// Providers configures the set of gas price sources queried by the
+	// GasOracleAggregator. When empty, the aggregator falls back to a single
+	// direct JSON-RPC provider.
+	Providers []GasProviderConfig
+
+	// JSONRPCURL is the endpoint queried by the "jsonrpc" provider (eth_gasPrice
+	// / eth_feeHistory), including the fallback path used when no Providers are
+	// configured. Defaults to a public Ethereum mainnet RPC endpoint when left
+	// unset.
+	JSONRPCURL string
+
+	// RewardPercentile is the eth_feeHistory reward percentile sampled to derive the
+	// suggested MaxPriorityFeePerGas. Defaults to 60 when left unset.
+	RewardPercentile float64
+
+	// FeeHistoryBlockCount is the number of past blocks sampled through eth_feeHistory.
+	// Defaults to 20. Ignored when LightMode is set.
+	FeeHistoryBlockCount int
+
+	// LightMode trims the eth_feeHistory window to 2 blocks with 3 reward samples
+	// each, trading suggestion accuracy for fewer/cheaper RPC calls.
+	LightMode bool
+}
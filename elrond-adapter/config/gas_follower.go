@@ -0,0 +1,28 @@
+package config
+
+import "time"
+
+// GasFollowerConfig configures the Kafka-based gas price follower/leader
+// subsystem used as an alternative to polling an HTTP gas station.
+type GasFollowerConfig struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+
+	TLSEnabled bool
+
+	SASLUsername string
+	SASLPassword string
+
+	// PublicKeyPEM is the PEM-encoded ECDSA public key used by followers to
+	// verify messages published by the leader.
+	PublicKeyPEM string
+
+	// PrivateKeyPEM is the PEM-encoded ECDSA private key used by the leader to
+	// sign published messages. Only needed in leader mode.
+	PrivateKeyPEM string
+
+	// MaxMessageAge rejects messages whose timestamp is older than this, to
+	// protect followers from replaying stale gas prices.
+	MaxMessageAge time.Duration
+}
@@ -0,0 +1,62 @@
+package feePolicy
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/ElrondNetwork/elrond-adapter/gasStation"
+)
+
+// Decision is the outcome of evaluating a price-update submission against the
+// configured gas price floor for its target asset.
+type Decision string
+
+const (
+	// DecisionSubmit means the current gas price clears the configured floor
+	// and the submission should be broadcast as-is.
+	DecisionSubmit Decision = "submit"
+	// DecisionDefer means the current gas price sits below the floor; the
+	// caller should hold off, or retry with a bumped gas price.
+	DecisionDefer Decision = "defer"
+)
+
+// GasFloor holds the minimum acceptable, asset-denominated gas price for
+// on-chain price submissions. Modeled on the Cosmos SDK's minGasPrices
+// validator option, where a minimum is expressed per-denomination rather than
+// as a single absolute fee.
+type GasFloor struct {
+	minimums map[string]*big.Float
+}
+
+// NewGasFloor parses the configured per-asset minimum gas prices.
+func NewGasFloor(cfg config.FeePolicyConfig) (*GasFloor, error) {
+	minimums := make(map[string]*big.Float, len(cfg.MinGasPrices))
+	for ticker, decimal := range cfg.MinGasPrices {
+		value, ok := new(big.Float).SetString(decimal)
+		if !ok {
+			return nil, fmt.Errorf("feePolicy: invalid minimum gas price %q for %s", decimal, ticker)
+		}
+		minimums[ticker] = value
+	}
+	return &GasFloor{minimums: minimums}, nil
+}
+
+// Evaluate compares a denominated gas price against the configured floor for
+// its asset. Assets without a configured floor always submit.
+func (g *GasFloor) Evaluate(denominated gasStation.GasPriceDenominated) (Decision, error) {
+	floor, ok := g.minimums[denominated.Base]
+	if !ok {
+		return DecisionSubmit, nil
+	}
+
+	current, ok := new(big.Float).SetString(denominated.Denomination)
+	if !ok {
+		return DecisionDefer, fmt.Errorf("feePolicy: could not parse denominated gas price %q", denominated.Denomination)
+	}
+
+	if current.Cmp(floor) < 0 {
+		return DecisionDefer, nil
+	}
+	return DecisionSubmit, nil
+}
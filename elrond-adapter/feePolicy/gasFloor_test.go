@@ -0,0 +1,67 @@
+package feePolicy
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/ElrondNetwork/elrond-adapter/gasStation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasFloor_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	floor, err := NewGasFloor(config.FeePolicyConfig{
+		MinGasPrices: map[string]string{
+			"EGLD": "0.000000001",
+			"ETH":  "0.5",
+		},
+	})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name        string
+		denominated gasStation.GasPriceDenominated
+		want        Decision
+	}{
+		{
+			name:        "above floor submits",
+			denominated: gasStation.GasPriceDenominated{Base: "ETH", Denomination: "0.6"},
+			want:        DecisionSubmit,
+		},
+		{
+			name:        "below floor defers",
+			denominated: gasStation.GasPriceDenominated{Base: "ETH", Denomination: "0.4"},
+			want:        DecisionDefer,
+		},
+		{
+			name:        "exactly at floor submits",
+			denominated: gasStation.GasPriceDenominated{Base: "EGLD", Denomination: "0.000000001"},
+			want:        DecisionSubmit,
+		},
+		{
+			name:        "asset without a configured floor always submits",
+			denominated: gasStation.GasPriceDenominated{Base: "BTC", Denomination: "0"},
+			want:        DecisionSubmit,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			decision, err := floor.Evaluate(tt.denominated)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, decision)
+		})
+	}
+}
+
+func TestNewGasFloor_InvalidMinimumErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGasFloor(config.FeePolicyConfig{
+		MinGasPrices: map[string]string{"ETH": "not-a-number"},
+	})
+	require.Error(t, err)
+}
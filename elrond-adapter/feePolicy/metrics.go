@@ -0,0 +1,19 @@
+package feePolicy
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// transactionsTotal counts price-update submissions per asset and outcome
+// ("submitted", "deferred", "bumped", "skipped" or "failed").
+var transactionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "elrond_adapter",
+		Subsystem: "fee_policy",
+		Name:      "transactions_total",
+		Help:      "Count of price-update submissions by asset and outcome.",
+	},
+	[]string{"asset", "outcome"},
+)
+
+func init() {
+	prometheus.MustRegister(transactionsTotal)
+}
@@ -0,0 +1,95 @@
+package feePolicy
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-adapter/gasStation"
+)
+
+// SubmitFunc broadcasts a price update at the given denominated gas price.
+type SubmitFunc func(asset string, denominated gasStation.GasPriceDenominated) error
+
+// BumpFunc recomputes a bumped denominated gas price for a deferred
+// submission, e.g. by requesting the next-higher speed tier. Submitter treats
+// a nil BumpFunc as "no bump available" and defers outright instead.
+type BumpFunc func(asset string) (gasStation.GasPriceDenominated, error)
+
+// DenominatedGasPriceSource supplies the current denominated gas price pairs
+// a Submitter evaluates against the configured GasFloor.
+// *gasStation.EthGasDenominator satisfies this interface.
+type DenominatedGasPriceSource interface {
+	GasPricesDenominated() []gasStation.GasPriceDenominated
+}
+
+// Submitter gates price-update submissions on a GasFloor, skipping,
+// deferring, or bumping the gas price when the network is congested, so a
+// caller that broadcasts through SubmitPriceUpdate doesn't have to duplicate
+// the floor check before every broadcast.
+type Submitter struct {
+	denominator DenominatedGasPriceSource
+	floor       *GasFloor
+	submit      SubmitFunc
+	bump        BumpFunc
+}
+
+// NewSubmitter builds a Submitter. bump may be nil, in which case deferred
+// submissions are held back rather than retried at a bumped gas price.
+func NewSubmitter(denominator DenominatedGasPriceSource, floor *GasFloor, submit SubmitFunc, bump BumpFunc) *Submitter {
+	return &Submitter{
+		denominator: denominator,
+		floor:       floor,
+		submit:      submit,
+		bump:        bump,
+	}
+}
+
+// SubmitPriceUpdate evaluates the current gas price for asset against the
+// configured floor and submits, defers, or bumps the submission accordingly.
+func (s *Submitter) SubmitPriceUpdate(asset string) error {
+	denominated, ok := findAsset(s.denominator.GasPricesDenominated(), asset)
+	if !ok {
+		transactionsTotal.WithLabelValues(asset, "skipped").Inc()
+		return fmt.Errorf("feePolicy: no denominated gas price available for %s", asset)
+	}
+
+	decision, err := s.floor.Evaluate(denominated)
+	if err != nil {
+		transactionsTotal.WithLabelValues(asset, "skipped").Inc()
+		return err
+	}
+
+	if decision == DecisionSubmit {
+		if err := s.submit(asset, denominated); err != nil {
+			transactionsTotal.WithLabelValues(asset, "failed").Inc()
+			return err
+		}
+		transactionsTotal.WithLabelValues(asset, "submitted").Inc()
+		return nil
+	}
+
+	if s.bump == nil {
+		transactionsTotal.WithLabelValues(asset, "deferred").Inc()
+		return nil
+	}
+
+	bumped, err := s.bump(asset)
+	if err != nil {
+		transactionsTotal.WithLabelValues(asset, "deferred").Inc()
+		return err
+	}
+	if err := s.submit(asset, bumped); err != nil {
+		transactionsTotal.WithLabelValues(asset, "failed").Inc()
+		return err
+	}
+	transactionsTotal.WithLabelValues(asset, "bumped").Inc()
+	return nil
+}
+
+func findAsset(pairs []gasStation.GasPriceDenominated, asset string) (gasStation.GasPriceDenominated, bool) {
+	for _, p := range pairs {
+		if p.Base == asset {
+			return p, true
+		}
+	}
+	return gasStation.GasPriceDenominated{}, false
+}
@@ -0,0 +1,119 @@
+package feePolicy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/ElrondNetwork/elrond-adapter/gasStation"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGasPriceSource struct {
+	pairs []gasStation.GasPriceDenominated
+}
+
+func (f fakeGasPriceSource) GasPricesDenominated() []gasStation.GasPriceDenominated {
+	return f.pairs
+}
+
+func TestSubmitter_SubmitPriceUpdateSubmitsAboveFloor(t *testing.T) {
+	t.Parallel()
+
+	floor, err := NewGasFloor(config.FeePolicyConfig{MinGasPrices: map[string]string{"ETH": "0.5"}})
+	require.Nil(t, err)
+
+	source := fakeGasPriceSource{pairs: []gasStation.GasPriceDenominated{{Base: "ETH", Denomination: "0.6"}}}
+
+	var submitted gasStation.GasPriceDenominated
+	submitter := NewSubmitter(source, floor, func(asset string, denominated gasStation.GasPriceDenominated) error {
+		submitted = denominated
+		return nil
+	}, nil)
+
+	require.Nil(t, submitter.SubmitPriceUpdate("ETH"))
+	require.Equal(t, "0.6", submitted.Denomination)
+}
+
+func TestSubmitter_SubmitPriceUpdateDefersWithoutBump(t *testing.T) {
+	t.Parallel()
+
+	floor, err := NewGasFloor(config.FeePolicyConfig{MinGasPrices: map[string]string{"ETH": "0.5"}})
+	require.Nil(t, err)
+
+	source := fakeGasPriceSource{pairs: []gasStation.GasPriceDenominated{{Base: "ETH", Denomination: "0.1"}}}
+
+	called := false
+	submitter := NewSubmitter(source, floor, func(asset string, denominated gasStation.GasPriceDenominated) error {
+		called = true
+		return nil
+	}, nil)
+
+	require.Nil(t, submitter.SubmitPriceUpdate("ETH"))
+	require.False(t, called)
+}
+
+func TestSubmitter_SubmitPriceUpdateBumpsWhenDeferred(t *testing.T) {
+	t.Parallel()
+
+	floor, err := NewGasFloor(config.FeePolicyConfig{MinGasPrices: map[string]string{"ETH": "0.5"}})
+	require.Nil(t, err)
+
+	source := fakeGasPriceSource{pairs: []gasStation.GasPriceDenominated{{Base: "ETH", Denomination: "0.1"}}}
+
+	var submitted gasStation.GasPriceDenominated
+	submitter := NewSubmitter(source, floor, func(asset string, denominated gasStation.GasPriceDenominated) error {
+		submitted = denominated
+		return nil
+	}, func(asset string) (gasStation.GasPriceDenominated, error) {
+		return gasStation.GasPriceDenominated{Base: "ETH", Denomination: "0.9"}, nil
+	})
+
+	require.Nil(t, submitter.SubmitPriceUpdate("ETH"))
+	require.Equal(t, "0.9", submitted.Denomination)
+}
+
+func TestSubmitter_SubmitPriceUpdateCountsFailedSubmit(t *testing.T) {
+	floor, err := NewGasFloor(config.FeePolicyConfig{MinGasPrices: map[string]string{"ETH": "0.5"}})
+	require.Nil(t, err)
+
+	source := fakeGasPriceSource{pairs: []gasStation.GasPriceDenominated{{Base: "ETH", Denomination: "0.6"}}}
+	submitter := NewSubmitter(source, floor, func(asset string, denominated gasStation.GasPriceDenominated) error {
+		return errors.New("broadcast failed")
+	}, nil)
+
+	before := testutil.ToFloat64(transactionsTotal.WithLabelValues("ETH", "failed"))
+	require.Error(t, submitter.SubmitPriceUpdate("ETH"))
+	require.Equal(t, before+1, testutil.ToFloat64(transactionsTotal.WithLabelValues("ETH", "failed")))
+}
+
+func TestSubmitter_SubmitPriceUpdateCountsFailedBumpSubmit(t *testing.T) {
+	floor, err := NewGasFloor(config.FeePolicyConfig{MinGasPrices: map[string]string{"ETH": "0.5"}})
+	require.Nil(t, err)
+
+	source := fakeGasPriceSource{pairs: []gasStation.GasPriceDenominated{{Base: "ETH", Denomination: "0.1"}}}
+	submitter := NewSubmitter(source, floor, func(asset string, denominated gasStation.GasPriceDenominated) error {
+		return errors.New("broadcast failed")
+	}, func(asset string) (gasStation.GasPriceDenominated, error) {
+		return gasStation.GasPriceDenominated{Base: "ETH", Denomination: "0.9"}, nil
+	})
+
+	before := testutil.ToFloat64(transactionsTotal.WithLabelValues("ETH", "failed"))
+	require.Error(t, submitter.SubmitPriceUpdate("ETH"))
+	require.Equal(t, before+1, testutil.ToFloat64(transactionsTotal.WithLabelValues("ETH", "failed")))
+}
+
+func TestSubmitter_SubmitPriceUpdateErrorsWhenAssetMissing(t *testing.T) {
+	t.Parallel()
+
+	floor, err := NewGasFloor(config.FeePolicyConfig{})
+	require.Nil(t, err)
+
+	source := fakeGasPriceSource{}
+	submitter := NewSubmitter(source, floor, func(asset string, denominated gasStation.GasPriceDenominated) error {
+		return errors.New("should not be called")
+	}, nil)
+
+	require.Error(t, submitter.SubmitPriceUpdate("ETH"))
+}
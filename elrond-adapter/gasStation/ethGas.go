@@ -0,0 +1,142 @@
+package gasStation
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-adapter/aggregator"
+	"github.com/ElrondNetwork/elrond-adapter/config"
+)
+
+// GasPriceGwei holds the aggregated Fast/Standard/Slow speed tiers, denominated
+// in Gwei.
+type GasPriceGwei struct {
+	Fast     uint64
+	Standard uint64
+	Slow     uint64
+}
+
+// GasPriceDenominated1559 holds the EIP-1559 fee components for a single target
+// asset, as produced by Denominate: in Gwei for ETH, and in whole units of the
+// asset (formatted to its configured decimals) for every other asset.
+type GasPriceDenominated1559 struct {
+	Base                 string
+	MaxFeePerGas         string
+	MaxPriorityFeePerGas string
+}
+
+// GasPriceDenominated holds the legacy gas price, denominated in a target asset.
+type GasPriceDenominated struct {
+	Base         string
+	Denomination string
+}
+
+// EthGasDenominator exposes Ethereum gas prices denominated in configured target
+// assets, using the exchange aggregator to convert between ETH and those assets.
+type EthGasDenominator struct {
+	exchange  *aggregator.ExchangeAggregator
+	gasConfig config.GasConfig
+	oracle    *GasOracleAggregator
+}
+
+// NewEthGasDenominator builds an EthGasDenominator backed by the given exchange
+// aggregator and gas configuration.
+func NewEthGasDenominator(exchange *aggregator.ExchangeAggregator, gasConfig config.GasConfig) *EthGasDenominator {
+	return &EthGasDenominator{
+		exchange:  exchange,
+		gasConfig: gasConfig,
+		oracle:    NewGasOracleAggregator(gasConfig),
+	}
+}
+
+// gasPriceGwei fetches the aggregated Fast/Standard/Slow speed tiers from the
+// configured gas price providers.
+func (e *EthGasDenominator) gasPriceGwei() (GasPriceGwei, error) {
+	gwei, _, err := e.oracle.FetchGasPriceGwei()
+	return gwei, err
+}
+
+// GasPricesDenominated returns the legacy (Fast-tier) gas price denominated in
+// each configured target asset.
+func (e *EthGasDenominator) GasPricesDenominated() []GasPriceDenominated {
+	gwei, err := e.gasPriceGwei()
+	if err != nil {
+		return nil
+	}
+
+	pairs := make([]GasPriceDenominated, 0, len(e.gasConfig.TargetAssets))
+	for _, asset := range e.gasConfig.TargetAssets {
+		denomination, err := e.denominate(gwei.Fast, asset)
+		if err != nil {
+			continue
+		}
+		pairs = append(pairs, GasPriceDenominated{
+			Base:         asset.Ticker,
+			Denomination: denomination,
+		})
+	}
+	return pairs
+}
+
+// GasPricesDenominated1559 returns the EIP-1559 MaxFeePerGas and
+// MaxPriorityFeePerGas, denominated in each configured target asset.
+func (e *EthGasDenominator) GasPricesDenominated1559() ([]GasPriceDenominated1559, error) {
+	fees, err := feeComponents1559(context.Background(), e.gasConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee := new(big.Int).Add(fees.baseFee, fees.priorityFee)
+
+	pairs := make([]GasPriceDenominated1559, 0, len(e.gasConfig.TargetAssets))
+	for _, asset := range e.gasConfig.TargetAssets {
+		maxFeeDenom, err := e.denominate(weiToGwei(maxFee), asset)
+		if err != nil {
+			continue
+		}
+		priorityDenom, err := e.denominate(weiToGwei(fees.priorityFee), asset)
+		if err != nil {
+			continue
+		}
+		pairs = append(pairs, GasPriceDenominated1559{
+			Base:                 asset.Ticker,
+			MaxFeePerGas:         maxFeeDenom,
+			MaxPriorityFeePerGas: priorityDenom,
+		})
+	}
+	return pairs, nil
+}
+
+// denominate converts a Gwei-denominated gas price into the given target asset.
+func (e *EthGasDenominator) denominate(gwei uint64, asset config.GasTargetAsset) (string, error) {
+	return Denominate(e.exchange, gwei, asset)
+}
+
+// Denominate converts a Gwei-denominated gas price into the given target
+// asset, using the exchange aggregator to look up USD rates. ETH is the
+// native unit of the gas price, so it is passed through unchanged; every
+// other asset is converted through its USD exchange rate against ETH.
+func Denominate(exchange *aggregator.ExchangeAggregator, gwei uint64, asset config.GasTargetAsset) (string, error) {
+	if asset.Ticker == "ETH" {
+		return strconv.FormatUint(gwei, 10), nil
+	}
+
+	ethPrice, err := exchange.FetchPrice("ETH", "USD")
+	if err != nil {
+		return "", err
+	}
+	targetPrice, err := exchange.FetchPrice(asset.Ticker, "USD")
+	if err != nil || targetPrice == 0 {
+		return "", fmt.Errorf("gasStation: could not price %s against USD", asset.Ticker)
+	}
+
+	gasInEth := new(big.Float).Quo(new(big.Float).SetUint64(gwei), big.NewFloat(1e9))
+	gasInTarget := new(big.Float).Quo(
+		new(big.Float).Mul(gasInEth, big.NewFloat(ethPrice)),
+		big.NewFloat(targetPrice),
+	)
+
+	return gasInTarget.Text('f', asset.Decimals), nil
+}
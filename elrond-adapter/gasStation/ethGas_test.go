@@ -1,6 +1,8 @@
 package gasStation
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
@@ -14,9 +16,23 @@ var getGasDenominator = func(gasConfig config.GasConfig) *EthGasDenominator {
 	return NewEthGasDenominator(exchange, gasConfig)
 }
 
+// newFakeJSONRPCServer stands in for a real Ethereum node, so the jsonrpc
+// fallback fetcher exercised by these tests doesn't depend on network egress.
+// baseFeePerGas and the reward sample are both 1 Gwei.
+func newFakeJSONRPCServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"baseFeePerGas":["0x3b9aca00"],"reward":[["0x3b9aca00"]]}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
 func TestEthGasDenominator_GasPriceDenominated(t *testing.T) {
 	t.Parallel()
+	server := newFakeJSONRPCServer(t)
 	gasDenom := getGasDenominator(config.GasConfig{
+		JSONRPCURL: server.URL,
 		TargetAssets: []config.GasTargetAsset{
 			{
 				Ticker:   "EGLD",
@@ -30,7 +46,9 @@ func TestEthGasDenominator_GasPriceDenominated(t *testing.T) {
 
 func TestEthGasDenominator_GasPricesDenominatedETH(t *testing.T) {
 	t.Parallel()
+	server := newFakeJSONRPCServer(t)
 	gasDenom := getGasDenominator(config.GasConfig{
+		JSONRPCURL: server.URL,
 		TargetAssets: []config.GasTargetAsset{
 			{
 				Ticker:   "ETH",
@@ -43,10 +61,32 @@ func TestEthGasDenominator_GasPricesDenominatedETH(t *testing.T) {
 	require.True(t, pairs[0].Denomination == strconv.FormatUint(gwei.Fast, 10))
 }
 
+func TestEthGasDenominator_GasPricesDenominated1559(t *testing.T) {
+	t.Parallel()
+	server := newFakeJSONRPCServer(t)
+	gasDenom := getGasDenominator(config.GasConfig{
+		JSONRPCURL: server.URL,
+		TargetAssets: []config.GasTargetAsset{
+			{
+				Ticker:   "ETH",
+				Decimals: 18,
+			},
+		},
+	})
+
+	pairs, err := gasDenom.GasPricesDenominated1559()
+	require.Nil(t, err)
+	require.True(t, len(pairs) == 1)
+	require.True(t, pairs[0].MaxFeePerGas != "")
+	require.True(t, pairs[0].MaxPriorityFeePerGas != "")
+}
+
 func TestEthGasDenominator_GasPricesDenominatedMultipleAssets(t *testing.T) {
 	t.Parallel()
 
+	server := newFakeJSONRPCServer(t)
 	gasDenom := getGasDenominator(config.GasConfig{
+		JSONRPCURL: server.URL,
 		TargetAssets: []config.GasTargetAsset{
 			{
 				Ticker:   "EGLD",
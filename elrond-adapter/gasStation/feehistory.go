@@ -0,0 +1,206 @@
+package gasStation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+)
+
+const (
+	// defaultJSONRPCURL is used when config.GasConfig.JSONRPCURL is left unset.
+	defaultJSONRPCURL = "https://eth-mainnet.public.blastapi.io"
+	weiPerGwei        = 1e9
+	defaultPercentile = 60
+
+	defaultFeeHistoryBlockCount = 20
+	lightFeeHistoryBlockCount   = 2
+	lightFeeHistoryRewardCount  = 3
+)
+
+type feeHistoryComponents struct {
+	baseFee     *big.Int
+	priorityFee *big.Int
+}
+
+type ethFeeHistoryResponse struct {
+	BaseFeePerGas []string   `json:"baseFeePerGas"`
+	Reward        [][]string `json:"reward"`
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      int           `json:"id"`
+}
+
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// feeComponents1559 samples eth_feeHistory for the configured block window and
+// returns the latest baseFeePerGas alongside a suggested priority fee, computed
+// as the median of the per-block reward percentile. Empty blocks (no reward at
+// the requested percentile) reuse the last non-empty sample.
+func feeComponents1559(ctx context.Context, gasConfig config.GasConfig) (feeHistoryComponents, error) {
+	blockCount := gasConfig.FeeHistoryBlockCount
+	rewardPercentiles := []float64{rewardPercentile(gasConfig)}
+	if gasConfig.LightMode {
+		blockCount = lightFeeHistoryBlockCount
+		rewardPercentiles = evenlySpacedPercentiles(lightFeeHistoryRewardCount)
+	} else if blockCount == 0 {
+		blockCount = defaultFeeHistoryBlockCount
+	}
+
+	history, err := fetchFeeHistory(ctx, jsonRPCURL(gasConfig), blockCount, rewardPercentiles)
+	if err != nil {
+		return feeHistoryComponents{}, err
+	}
+
+	baseFee, ok := new(big.Int).SetString(history.BaseFeePerGas[len(history.BaseFeePerGas)-1], 0)
+	if !ok {
+		return feeHistoryComponents{}, fmt.Errorf("gasStation: could not parse baseFeePerGas")
+	}
+
+	priorityFee, err := medianReward(history.Reward)
+	if err != nil {
+		return feeHistoryComponents{}, err
+	}
+
+	return feeHistoryComponents{baseFee: baseFee, priorityFee: priorityFee}, nil
+}
+
+func rewardPercentile(gasConfig config.GasConfig) float64 {
+	if gasConfig.RewardPercentile == 0 {
+		return defaultPercentile
+	}
+	return gasConfig.RewardPercentile
+}
+
+// jsonRPCURL returns the configured JSON-RPC endpoint, falling back to a
+// public Ethereum mainnet node when gasConfig.JSONRPCURL is left unset.
+func jsonRPCURL(gasConfig config.GasConfig) string {
+	if gasConfig.JSONRPCURL == "" {
+		return defaultJSONRPCURL
+	}
+	return gasConfig.JSONRPCURL
+}
+
+func fetchFeeHistory(ctx context.Context, url string, blockCount int, rewardPercentiles []float64) (ethFeeHistoryResponse, error) {
+	body, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		Method:  "eth_feeHistory",
+		Params:  []interface{}{blockCount, "pending", rewardPercentiles},
+		ID:      1,
+	})
+	if err != nil {
+		return ethFeeHistoryResponse{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return ethFeeHistoryResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ethFeeHistoryResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	var rpcResp jsonRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return ethFeeHistoryResponse{}, err
+	}
+	if rpcResp.Error != nil {
+		return ethFeeHistoryResponse{}, fmt.Errorf("gasStation: eth_feeHistory: %s", rpcResp.Error.Message)
+	}
+
+	var history ethFeeHistoryResponse
+	if err := json.Unmarshal(rpcResp.Result, &history); err != nil {
+		return ethFeeHistoryResponse{}, err
+	}
+	if len(history.BaseFeePerGas) == 0 {
+		return ethFeeHistoryResponse{}, fmt.Errorf("gasStation: empty eth_feeHistory response")
+	}
+	return history, nil
+}
+
+// medianReward reduces each block's requested reward percentiles to a single
+// representative value (their median), reusing the previous block's
+// representative whenever a block came back empty, then returns the median
+// of those per-block representatives across the sampled window.
+func medianReward(reward [][]string) (*big.Int, error) {
+	samples := make([]*big.Int, 0, len(reward))
+	var last *big.Int
+	for _, blockRewards := range reward {
+		if len(blockRewards) == 0 {
+			if last != nil {
+				samples = append(samples, last)
+			}
+			continue
+		}
+
+		parsed, err := parseBigInts(blockRewards)
+		if err != nil {
+			continue
+		}
+
+		representative := medianBigInt(parsed)
+		last = representative
+		samples = append(samples, representative)
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("gasStation: no reward samples to derive a priority fee from")
+	}
+
+	return medianBigInt(samples), nil
+}
+
+func parseBigInts(values []string) ([]*big.Int, error) {
+	parsed := make([]*big.Int, 0, len(values))
+	for _, v := range values {
+		value, ok := new(big.Int).SetString(v, 0)
+		if !ok {
+			return nil, fmt.Errorf("gasStation: could not parse reward sample %q", v)
+		}
+		parsed = append(parsed, value)
+	}
+	return parsed, nil
+}
+
+// medianBigInt returns the median of values, averaging the two middle values
+// for an even-length input.
+func medianBigInt(values []*big.Int) *big.Int {
+	sorted := append([]*big.Int(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return new(big.Int).Div(new(big.Int).Add(sorted[mid-1], sorted[mid]), big.NewInt(2))
+	}
+	return sorted[mid]
+}
+
+func evenlySpacedPercentiles(n int) []float64 {
+	percentiles := make([]float64, n)
+	for i := 0; i < n; i++ {
+		percentiles[i] = float64(100*(i+1)) / float64(n+1)
+	}
+	return percentiles
+}
+
+func weiToGwei(wei *big.Int) uint64 {
+	gwei := new(big.Int).Div(wei, big.NewInt(int64(weiPerGwei)))
+	return gwei.Uint64()
+}
@@ -0,0 +1,46 @@
+package gasStation
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMedianReward_UsesAllSamplesPerBlock(t *testing.T) {
+	t.Parallel()
+
+	// Each block reports 3 ascending reward percentiles (e.g. 25th/50th/75th).
+	// The per-block representative must be the middle (median) sample, not
+	// the last (highest-percentile) one.
+	reward := [][]string{
+		{"10", "20", "30"},
+		{"11", "21", "31"},
+	}
+
+	got, err := medianReward(reward)
+	require.Nil(t, err)
+	// Per-block medians are 20 and 21; median across blocks is their average.
+	require.True(t, got.Cmp(big.NewInt(20)) >= 0 && got.Cmp(big.NewInt(21)) <= 0)
+}
+
+func TestMedianReward_ReusesLastRepresentativeForEmptyBlocks(t *testing.T) {
+	t.Parallel()
+
+	reward := [][]string{
+		{"10", "20", "30"},
+		{},
+		{},
+	}
+
+	got, err := medianReward(reward)
+	require.Nil(t, err)
+	require.True(t, got.Cmp(big.NewInt(20)) == 0)
+}
+
+func TestMedianReward_ErrorsWhenNoSamples(t *testing.T) {
+	t.Parallel()
+
+	_, err := medianReward([][]string{{}, {}})
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package gasStation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+)
+
+var errGasStationUnavailable = errors.New("gasStation: ethgasstation endpoint unavailable")
+
+// GasPriceFetcher fetches a Fast/Standard/Slow gas price triple from a single
+// upstream source. Implementations must honor ctx cancellation/deadlines so a
+// slow or hanging upstream can't outlive the aggregator's per-provider
+// timeout.
+type GasPriceFetcher interface {
+	Name() string
+	FetchGasPrice(ctx context.Context) (GasPriceGwei, error)
+}
+
+const gasStationURL = "https://ethgasstation.info/api/ethgasAPI.json"
+
+type ethGasStationResponse struct {
+	Fast    float64 `json:"fast"`
+	Average float64 `json:"average"`
+	SafeLow float64 `json:"safeLow"`
+}
+
+// ethGasStationFetcher queries the legacy ethgasstation.info endpoint.
+type ethGasStationFetcher struct{}
+
+func (f ethGasStationFetcher) Name() string { return "ethgasstation" }
+
+func (f ethGasStationFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gasStationURL, nil)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	defer resp.Body.Close()
+
+	var parsed ethGasStationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil || parsed.Fast == 0 {
+		return GasPriceGwei{}, errGasStationUnavailable
+	}
+
+	// ethgasstation denominates its tiers in tenths of a Gwei.
+	return GasPriceGwei{
+		Fast:     uint64(parsed.Fast / 10),
+		Standard: uint64(parsed.Average / 10),
+		Slow:     uint64(parsed.SafeLow / 10),
+	}, nil
+}
+
+// jsonRPCFetcher derives a Fast/Standard/Slow triple from baseFee + tip, sampled
+// directly via eth_feeHistory. It is the fetcher of last resort, since it only
+// needs a public JSON-RPC endpoint to work.
+type jsonRPCFetcher struct {
+	gasConfig config.GasConfig
+}
+
+func (f jsonRPCFetcher) Name() string { return "jsonrpc" }
+
+func (f jsonRPCFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	fees, err := feeComponents1559(ctx, f.gasConfig)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+
+	gwei := weiToGwei(new(big.Int).Add(fees.baseFee, fees.priorityFee))
+	return GasPriceGwei{
+		Fast:     gwei,
+		Standard: gwei,
+		Slow:     gwei,
+	}, nil
+}
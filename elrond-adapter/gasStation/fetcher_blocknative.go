@@ -0,0 +1,76 @@
+package gasStation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const blocknativeGasPlatformURL = "https://api.blocknative.com/gasprices/blockprices"
+
+type blocknativeResponse struct {
+	BlockPrices []struct {
+		EstimatedPrices []struct {
+			Confidence int     `json:"confidence"`
+			Price      float64 `json:"price"`
+		} `json:"estimatedPrices"`
+	} `json:"blockPrices"`
+}
+
+// blocknativeFetcher queries the Blocknative gas platform API, using the 90th,
+// 70th and 50th confidence estimates as the Fast/Standard/Slow tiers.
+type blocknativeFetcher struct {
+	apiKey string
+}
+
+func (f blocknativeFetcher) Name() string { return "blocknative" }
+
+// blocknativeRequiredConfidences are the confidence levels this fetcher maps
+// onto the Fast/Standard/Slow tiers. Blocknative is known to vary the set of
+// confidence levels returned by plan/endpoint, so all three must be present.
+var blocknativeRequiredConfidences = [3]int{90, 70, 50}
+
+func (f blocknativeFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	return f.fetchFrom(ctx, blocknativeGasPlatformURL)
+}
+
+// fetchFrom is FetchGasPrice with the endpoint broken out so tests can point
+// it at a mock server.
+func (f blocknativeFetcher) fetchFrom(ctx context.Context, url string) (GasPriceGwei, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	req.Header.Set("Authorization", f.apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	defer resp.Body.Close()
+
+	var parsed blocknativeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return GasPriceGwei{}, err
+	}
+	if len(parsed.BlockPrices) == 0 {
+		return GasPriceGwei{}, fmt.Errorf("blocknative: empty blockPrices response")
+	}
+
+	tiers := map[int]float64{}
+	for _, estimate := range parsed.BlockPrices[0].EstimatedPrices {
+		tiers[estimate.Confidence] = estimate.Price
+	}
+	for _, confidence := range blocknativeRequiredConfidences {
+		if _, ok := tiers[confidence]; !ok {
+			return GasPriceGwei{}, fmt.Errorf("blocknative: missing confidence %d estimate in response", confidence)
+		}
+	}
+
+	return GasPriceGwei{
+		Fast:     uint64(tiers[90]),
+		Standard: uint64(tiers[70]),
+		Slow:     uint64(tiers[50]),
+	}, nil
+}
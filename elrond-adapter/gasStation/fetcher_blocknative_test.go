@@ -0,0 +1,38 @@
+package gasStation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocknativeFetcher_FetchGasPriceErrorsOnMissingConfidence(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Missing the 50th-confidence estimate the Slow tier needs.
+		w.Write([]byte(`{"blockPrices":[{"estimatedPrices":[{"confidence":90,"price":42},{"confidence":70,"price":30}]}]}`))
+	}))
+	defer server.Close()
+
+	f := blocknativeFetcher{apiKey: "key"}
+	_, err := f.fetchFrom(context.Background(), server.URL)
+	require.Error(t, err)
+}
+
+func TestBlocknativeFetcher_FetchGasPriceReturnsAllTiers(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"blockPrices":[{"estimatedPrices":[{"confidence":90,"price":42},{"confidence":70,"price":30},{"confidence":50,"price":20}]}]}`))
+	}))
+	defer server.Close()
+
+	f := blocknativeFetcher{apiKey: "key"}
+	gwei, err := f.fetchFrom(context.Background(), server.URL)
+	require.Nil(t, err)
+	require.Equal(t, GasPriceGwei{Fast: 42, Standard: 30, Slow: 20}, gwei)
+}
@@ -0,0 +1,62 @@
+package gasStation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const etherscanGasTrackerURL = "https://api.etherscan.io/api?module=gastracker&action=gasoracle&apikey=%s"
+
+type etherscanResponse struct {
+	Status string `json:"status"`
+	Result struct {
+		FastGasPrice    string `json:"FastGasPrice"`
+		ProposeGasPrice string `json:"ProposeGasPrice"`
+		SafeGasPrice    string `json:"SafeGasPrice"`
+	} `json:"result"`
+}
+
+// etherscanFetcher queries the Etherscan gas tracker API.
+type etherscanFetcher struct {
+	apiKey string
+}
+
+func (f etherscanFetcher) Name() string { return "etherscan" }
+
+func (f etherscanFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(etherscanGasTrackerURL, f.apiKey), nil)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	defer resp.Body.Close()
+
+	var parsed etherscanResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return GasPriceGwei{}, err
+	}
+	if parsed.Status != "1" {
+		return GasPriceGwei{}, fmt.Errorf("etherscan: gas oracle returned status %q", parsed.Status)
+	}
+
+	fast, err := strconv.ParseUint(parsed.Result.FastGasPrice, 10, 64)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	standard, err := strconv.ParseUint(parsed.Result.ProposeGasPrice, 10, 64)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	slow, err := strconv.ParseUint(parsed.Result.SafeGasPrice, 10, 64)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+
+	return GasPriceGwei{Fast: fast, Standard: standard, Slow: slow}, nil
+}
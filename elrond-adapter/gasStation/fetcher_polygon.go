@@ -0,0 +1,47 @@
+package gasStation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const polygonGasStationURL = "https://gasstation.polygon.technology/v2"
+
+type polygonGasStationResponse struct {
+	Fast     polygonFeeTier `json:"fast"`
+	Standard polygonFeeTier `json:"standard"`
+	Safe     polygonFeeTier `json:"safeLow"`
+}
+
+type polygonFeeTier struct {
+	MaxFee float64 `json:"maxFee"`
+}
+
+// polygonGasStationFetcher queries the Polygon gas station API.
+type polygonGasStationFetcher struct{}
+
+func (f polygonGasStationFetcher) Name() string { return "polygon" }
+
+func (f polygonGasStationFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, polygonGasStationURL, nil)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return GasPriceGwei{}, err
+	}
+	defer resp.Body.Close()
+
+	var parsed polygonGasStationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return GasPriceGwei{}, err
+	}
+
+	return GasPriceGwei{
+		Fast:     uint64(parsed.Fast.MaxFee),
+		Standard: uint64(parsed.Standard.MaxFee),
+		Slow:     uint64(parsed.Safe.MaxFee),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package follower
+
+import "sync"
+
+// priceCache holds the latest gas price applied by the consumer, read by the
+// FollowerGasDenominator on each denomination request.
+type priceCache struct {
+	mu    sync.RWMutex
+	price uint64
+}
+
+func newPriceCache() *priceCache {
+	return &priceCache{}
+}
+
+func (c *priceCache) set(price uint64) {
+	c.mu.Lock()
+	c.price = price
+	c.mu.Unlock()
+}
+
+func (c *priceCache) get() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.price
+}
@@ -0,0 +1,106 @@
+package follower
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+)
+
+var (
+	errInvalidSignature = errors.New("follower: message signature does not verify")
+	errStaleMessage     = errors.New("follower: message older than the configured max age")
+	errDuplicateMessage = errors.New("follower: message timestamp at or behind the current watermark")
+)
+
+// KafkaGasConsumer subscribes to a Kafka topic carrying signed gas-price
+// messages from an upstream leader adapter and applies them to an in-memory
+// cache, verifying each message's ECDSA signature and rejecting stale or
+// duplicate messages by timestamp watermark.
+type KafkaGasConsumer struct {
+	reader    *kafka.Reader
+	publicKey *ecdsa.PublicKey
+	maxAge    time.Duration
+
+	cache     *priceCache
+	watermark int64
+}
+
+// NewKafkaGasConsumer builds a KafkaGasConsumer backed by the given follower
+// configuration, writing accepted gas prices to cache.
+func NewKafkaGasConsumer(cfg config.GasFollowerConfig, cache *priceCache) (*KafkaGasConsumer, error) {
+	publicKey, err := parseECDSAPublicKey(cfg.PublicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &kafka.Dialer{Timeout: 10 * time.Second, DualStack: true}
+	if cfg.TLSEnabled {
+		dialer.TLS = &tls.Config{}
+	}
+	if cfg.SASLUsername != "" {
+		dialer.SASLMechanism = plain.Mechanism{Username: cfg.SASLUsername, Password: cfg.SASLPassword}
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: cfg.Brokers,
+		Topic:   cfg.Topic,
+		GroupID: cfg.GroupID,
+		Dialer:  dialer,
+	})
+
+	return &KafkaGasConsumer{
+		reader:    reader,
+		publicKey: publicKey,
+		maxAge:    cfg.MaxMessageAge,
+		cache:     cache,
+	}, nil
+}
+
+// Run consumes messages until the context is cancelled or the reader errors.
+// Messages that fail verification, staleness, or watermark checks are skipped
+// rather than treated as fatal.
+func (c *KafkaGasConsumer) Run(ctx context.Context) error {
+	for {
+		msg, err := c.reader.ReadMessage(ctx)
+		if err != nil {
+			return err
+		}
+		_ = c.handle(msg.Value)
+	}
+}
+
+// Close releases the underlying Kafka reader.
+func (c *KafkaGasConsumer) Close() error {
+	return c.reader.Close()
+}
+
+func (c *KafkaGasConsumer) handle(raw []byte) error {
+	var payload GasPriceMessage
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return err
+	}
+
+	if !verify(c.publicKey, payload.GasPrice, payload.Timestamp, payload.Signature) {
+		return errInvalidSignature
+	}
+
+	age := time.Since(time.Unix(payload.Timestamp, 0))
+	if c.maxAge > 0 && age > c.maxAge {
+		return errStaleMessage
+	}
+
+	if payload.Timestamp <= c.watermark {
+		return errDuplicateMessage
+	}
+	c.watermark = payload.Timestamp
+
+	c.cache.set(payload.GasPrice)
+	return nil
+}
@@ -0,0 +1,71 @@
+package follower
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConsumer(t *testing.T, maxAge time.Duration) (*KafkaGasConsumer, *ecdsa.PrivateKey) {
+	t.Helper()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	return &KafkaGasConsumer{
+		publicKey: &privateKey.PublicKey,
+		maxAge:    maxAge,
+		cache:     newPriceCache(),
+	}, privateKey
+}
+
+func signedMessage(t *testing.T, privateKey *ecdsa.PrivateKey, gasPrice uint64, timestamp int64) []byte {
+	t.Helper()
+	signature, err := sign(privateKey, gasPrice, timestamp)
+	require.Nil(t, err)
+
+	body, err := json.Marshal(GasPriceMessage{GasPrice: gasPrice, Timestamp: timestamp, Signature: signature})
+	require.Nil(t, err)
+	return body
+}
+
+func TestKafkaGasConsumer_HandleAcceptsValidMessage(t *testing.T) {
+	t.Parallel()
+	consumer, privateKey := newTestConsumer(t, time.Hour)
+
+	err := consumer.handle(signedMessage(t, privateKey, 42, time.Now().Unix()))
+	require.Nil(t, err)
+	require.True(t, consumer.cache.get() == 42)
+}
+
+func TestKafkaGasConsumer_HandleRejectsInvalidSignature(t *testing.T) {
+	t.Parallel()
+	consumer, _ := newTestConsumer(t, time.Hour)
+	_, otherKey := newTestConsumer(t, time.Hour)
+
+	err := consumer.handle(signedMessage(t, otherKey, 42, time.Now().Unix()))
+	require.Equal(t, errInvalidSignature, err)
+}
+
+func TestKafkaGasConsumer_HandleRejectsStaleMessage(t *testing.T) {
+	t.Parallel()
+	consumer, privateKey := newTestConsumer(t, time.Minute)
+
+	err := consumer.handle(signedMessage(t, privateKey, 42, time.Now().Add(-time.Hour).Unix()))
+	require.Equal(t, errStaleMessage, err)
+}
+
+func TestKafkaGasConsumer_HandleRejectsDuplicateMessage(t *testing.T) {
+	t.Parallel()
+	consumer, privateKey := newTestConsumer(t, time.Hour)
+
+	now := time.Now().Unix()
+	require.Nil(t, consumer.handle(signedMessage(t, privateKey, 42, now)))
+
+	err := consumer.handle(signedMessage(t, privateKey, 43, now))
+	require.Equal(t, errDuplicateMessage, err)
+}
@@ -0,0 +1,53 @@
+package follower
+
+import (
+	"github.com/ElrondNetwork/elrond-adapter/aggregator"
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/ElrondNetwork/elrond-adapter/gasStation"
+)
+
+// FollowerGasDenominator implements the same GasPricesDenominated() surface as
+// gasStation.EthGasDenominator, but reads the underlying gas price from a
+// cache kept up to date by a KafkaGasConsumer instead of polling an HTTP gas
+// station.
+type FollowerGasDenominator struct {
+	exchange  *aggregator.ExchangeAggregator
+	gasConfig config.GasConfig
+	cache     *priceCache
+}
+
+// NewFollowerGasDenominator builds a FollowerGasDenominator and the
+// KafkaGasConsumer that feeds it, wiring the consumer's cache into the
+// returned denominator.
+func NewFollowerGasDenominator(exchange *aggregator.ExchangeAggregator, gasConfig config.GasConfig, followerConfig config.GasFollowerConfig) (*FollowerGasDenominator, *KafkaGasConsumer, error) {
+	cache := newPriceCache()
+	consumer, err := NewKafkaGasConsumer(followerConfig, cache)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &FollowerGasDenominator{
+		exchange:  exchange,
+		gasConfig: gasConfig,
+		cache:     cache,
+	}, consumer, nil
+}
+
+// GasPricesDenominated returns the last gas price applied by the consumer,
+// denominated in each configured target asset.
+func (f *FollowerGasDenominator) GasPricesDenominated() []gasStation.GasPriceDenominated {
+	gwei := f.cache.get()
+
+	pairs := make([]gasStation.GasPriceDenominated, 0, len(f.gasConfig.TargetAssets))
+	for _, asset := range f.gasConfig.TargetAssets {
+		denomination, err := gasStation.Denominate(f.exchange, gwei, asset)
+		if err != nil {
+			continue
+		}
+		pairs = append(pairs, gasStation.GasPriceDenominated{
+			Base:         asset.Ticker,
+			Denomination: denomination,
+		})
+	}
+	return pairs
+}
@@ -0,0 +1,69 @@
+package follower
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+)
+
+// GasPriceMessage is the wire format published by a leader adapter and
+// consumed by its followers.
+type GasPriceMessage struct {
+	GasPrice  uint64 `json:"gasPrice"`
+	Timestamp int64  `json:"timestamp"`
+	Signature string `json:"signature"`
+}
+
+func signingPayload(gasPrice uint64, timestamp int64) []byte {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf[:8], gasPrice)
+	binary.BigEndian.PutUint64(buf[8:], uint64(timestamp))
+	return buf
+}
+
+func sign(privateKey *ecdsa.PrivateKey, gasPrice uint64, timestamp int64) (string, error) {
+	hash := sha256.Sum256(signingPayload(gasPrice, timestamp))
+	sig, err := ecdsa.SignASN1(rand.Reader, privateKey, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+func verify(publicKey *ecdsa.PublicKey, gasPrice uint64, timestamp int64, signature string) bool {
+	sigBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	hash := sha256.Sum256(signingPayload(gasPrice, timestamp))
+	return ecdsa.VerifyASN1(publicKey, hash[:], sigBytes)
+}
+
+func parseECDSAPublicKey(pemEncoded string) (*ecdsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemEncoded))
+	if block == nil {
+		return nil, errors.New("follower: invalid PEM-encoded public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecdsaKey, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("follower: public key is not ECDSA")
+	}
+	return ecdsaKey, nil
+}
+
+func parseECDSAPrivateKey(pemEncoded string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemEncoded))
+	if block == nil {
+		return nil, errors.New("follower: invalid PEM-encoded private key")
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
@@ -0,0 +1,110 @@
+package follower
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/ElrondNetwork/elrond-adapter/gasStation"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+)
+
+// GasPriceSource supplies the gas price a KafkaGasPublisher should sign and
+// publish. *gasStation.GasOracleAggregator satisfies this interface.
+type GasPriceSource interface {
+	FetchGasPriceGwei() (gasStation.GasPriceGwei, gasStation.GasOracleMetadata, error)
+}
+
+// KafkaGasPublisher runs an adapter in "leader" mode: it signs the current gas
+// price with an ECDSA private key and publishes it to a Kafka topic so that N
+// other adapters can run as followers against the same price.
+type KafkaGasPublisher struct {
+	writer     *kafka.Writer
+	privateKey *ecdsa.PrivateKey
+	source     GasPriceSource
+}
+
+// NewKafkaGasPublisher builds a KafkaGasPublisher backed by the given follower
+// configuration and gas price source.
+func NewKafkaGasPublisher(cfg config.GasFollowerConfig, source GasPriceSource) (*KafkaGasPublisher, error) {
+	privateKey, err := parseECDSAPrivateKey(cfg.PrivateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &kafka.Dialer{Timeout: 10 * time.Second, DualStack: true}
+	if cfg.TLSEnabled {
+		dialer.TLS = &tls.Config{}
+	}
+	if cfg.SASLUsername != "" {
+		dialer.SASLMechanism = plain.Mechanism{Username: cfg.SASLUsername, Password: cfg.SASLPassword}
+	}
+
+	writer := &kafka.Writer{
+		Addr:     kafka.TCP(cfg.Brokers...),
+		Topic:    cfg.Topic,
+		Balancer: &kafka.LeastBytes{},
+		Transport: &kafka.Transport{
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, address)
+			},
+		},
+	}
+
+	return &KafkaGasPublisher{
+		writer:     writer,
+		privateKey: privateKey,
+		source:     source,
+	}, nil
+}
+
+// Run publishes the current gas price on the given interval until the context
+// is cancelled.
+func (p *KafkaGasPublisher) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			_ = p.PublishOnce(ctx)
+		}
+	}
+}
+
+// PublishOnce signs and publishes a single gas price message.
+func (p *KafkaGasPublisher) PublishOnce(ctx context.Context) error {
+	gwei, _, err := p.source.FetchGasPriceGwei()
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Unix()
+	signature, err := sign(p.privateKey, gwei.Fast, timestamp)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(GasPriceMessage{
+		GasPrice:  gwei.Fast,
+		Timestamp: timestamp,
+		Signature: signature,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.writer.WriteMessages(ctx, kafka.Message{Value: body})
+}
+
+// Close releases the underlying Kafka writer.
+func (p *KafkaGasPublisher) Close() error {
+	return p.writer.Close()
+}
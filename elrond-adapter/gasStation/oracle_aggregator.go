@@ -0,0 +1,252 @@
+package gasStation
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+)
+
+const defaultProviderTimeout = 3 * time.Second
+
+var (
+	errProviderTimedOut     = errors.New("gasStation: provider timed out")
+	errNoProvidersResponded = errors.New("gasStation: no gas price providers responded")
+)
+
+// TierMetadata records which providers contributed to (or were rejected from)
+// a single speed tier's aggregated value.
+type TierMetadata struct {
+	ContributingProviders []string
+	RejectedProviders     []string
+}
+
+// GasOracleMetadata records, per speed tier, which providers contributed to
+// (or were rejected from) an aggregated gas price result. A provider can be
+// rejected either because it failed to respond at all (reflected in every
+// tier) or because its quote was filtered as an outlier for that particular
+// tier.
+type GasOracleMetadata struct {
+	Fast     TierMetadata
+	Standard TierMetadata
+	Slow     TierMetadata
+}
+
+type configuredFetcher struct {
+	fetcher GasPriceFetcher
+	weight  float64
+	timeout time.Duration
+}
+
+// GasOracleAggregator queries several gas price providers in parallel and
+// combines them with a per-speed-tier median + MAD (median absolute
+// deviation) outlier rejection pass, degrading gracefully as long as at least
+// one provider responds.
+type GasOracleAggregator struct {
+	fetchers []configuredFetcher
+}
+
+// NewGasOracleAggregator builds a GasOracleAggregator from the providers
+// configured in gasConfig. When no providers are configured, it falls back to
+// a single direct JSON-RPC fetcher.
+func NewGasOracleAggregator(gasConfig config.GasConfig) *GasOracleAggregator {
+	var fetchers []configuredFetcher
+	for _, provider := range gasConfig.Providers {
+		if provider.Disabled {
+			continue
+		}
+		fetcher := newProviderFetcher(provider, gasConfig)
+		if fetcher == nil {
+			log.Printf("gasStation: ignoring unrecognized provider %q in GasConfig.Providers", provider.Name)
+			continue
+		}
+
+		weight := provider.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		timeout := provider.Timeout
+		if timeout == 0 {
+			timeout = defaultProviderTimeout
+		}
+		fetchers = append(fetchers, configuredFetcher{fetcher: fetcher, weight: weight, timeout: timeout})
+	}
+
+	if len(fetchers) == 0 {
+		if len(gasConfig.Providers) > 0 {
+			log.Printf("gasStation: all %d configured providers were disabled or unrecognized, falling back to a single direct jsonrpc provider", len(gasConfig.Providers))
+		}
+		fetchers = append(fetchers, configuredFetcher{
+			fetcher: jsonRPCFetcher{gasConfig: gasConfig},
+			weight:  1,
+			timeout: defaultProviderTimeout,
+		})
+	}
+
+	return &GasOracleAggregator{fetchers: fetchers}
+}
+
+func newProviderFetcher(provider config.GasProviderConfig, gasConfig config.GasConfig) GasPriceFetcher {
+	switch provider.Name {
+	case "ethgasstation":
+		return ethGasStationFetcher{}
+	case "etherscan":
+		return etherscanFetcher{apiKey: provider.APIKey}
+	case "blocknative":
+		return blocknativeFetcher{apiKey: provider.APIKey}
+	case "polygon":
+		return polygonGasStationFetcher{}
+	case "jsonrpc":
+		return jsonRPCFetcher{gasConfig: gasConfig}
+	default:
+		return nil
+	}
+}
+
+type fetchResult struct {
+	provider string
+	price    GasPriceGwei
+	weight   float64
+	err      error
+}
+
+// FetchGasPriceGwei queries every configured provider concurrently, bounded by
+// its own timeout, and combines the survivors via per-tier median + MAD
+// outlier rejection. It succeeds as long as at least one provider responds.
+func (a *GasOracleAggregator) FetchGasPriceGwei() (GasPriceGwei, GasOracleMetadata, error) {
+	results := make(chan fetchResult, len(a.fetchers))
+	var wg sync.WaitGroup
+
+	for _, cf := range a.fetchers {
+		wg.Add(1)
+		go func(cf configuredFetcher) {
+			defer wg.Done()
+			results <- cf.fetch()
+		}(cf)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var succeeded []fetchResult
+	var unresponsive []string
+	for res := range results {
+		if res.err != nil {
+			unresponsive = append(unresponsive, res.provider)
+			continue
+		}
+		succeeded = append(succeeded, res)
+	}
+
+	if len(succeeded) == 0 {
+		return GasPriceGwei{}, GasOracleMetadata{
+			Fast:     TierMetadata{RejectedProviders: unresponsive},
+			Standard: TierMetadata{RejectedProviders: unresponsive},
+			Slow:     TierMetadata{RejectedProviders: unresponsive},
+		}, errNoProvidersResponded
+	}
+
+	fast, fastMeta := aggregateTier(succeeded, func(r fetchResult) uint64 { return r.price.Fast })
+	standard, standardMeta := aggregateTier(succeeded, func(r fetchResult) uint64 { return r.price.Standard })
+	slow, slowMeta := aggregateTier(succeeded, func(r fetchResult) uint64 { return r.price.Slow })
+
+	fastMeta.RejectedProviders = append(append([]string(nil), unresponsive...), fastMeta.RejectedProviders...)
+	standardMeta.RejectedProviders = append(append([]string(nil), unresponsive...), standardMeta.RejectedProviders...)
+	slowMeta.RejectedProviders = append(append([]string(nil), unresponsive...), slowMeta.RejectedProviders...)
+
+	metadata := GasOracleMetadata{Fast: fastMeta, Standard: standardMeta, Slow: slowMeta}
+
+	return GasPriceGwei{Fast: fast, Standard: standard, Slow: slow}, metadata, nil
+}
+
+// fetch runs the fetcher with its configured timeout threaded through a
+// context, so a provider that never responds is actually cancelled at the
+// network layer instead of merely being abandoned by the caller.
+func (cf configuredFetcher) fetch() fetchResult {
+	ctx, cancel := context.WithTimeout(context.Background(), cf.timeout)
+	defer cancel()
+
+	price, err := cf.fetcher.FetchGasPrice(ctx)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fetchResult{provider: cf.fetcher.Name(), err: errProviderTimedOut}
+		}
+		return fetchResult{provider: cf.fetcher.Name(), err: err}
+	}
+	return fetchResult{provider: cf.fetcher.Name(), price: price, weight: cf.weight}
+}
+
+// aggregateTier combines a single speed tier across all successful providers,
+// rejecting values that are more than 3 median absolute deviations from the
+// median before taking the weighted median of the survivors. When the median
+// absolute deviation is zero, no value is rejected.
+func aggregateTier(results []fetchResult, tierValue func(fetchResult) uint64) (uint64, TierMetadata) {
+	values := make([]float64, len(results))
+	for i, r := range results {
+		values[i] = float64(tierValue(r))
+	}
+
+	med := median(values)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = absFloat(v - med)
+	}
+	mad := median(deviations)
+
+	var survivors []float64
+	var meta TierMetadata
+	for i, r := range results {
+		if mad > 0 && absFloat(values[i]-med) > 3*mad {
+			meta.RejectedProviders = append(meta.RejectedProviders, r.provider)
+			continue
+		}
+		for n := 0; n < weightRepeats(r.weight); n++ {
+			survivors = append(survivors, values[i])
+		}
+		meta.ContributingProviders = append(meta.ContributingProviders, r.provider)
+	}
+	if len(survivors) == 0 {
+		survivors = values
+		meta.ContributingProviders = nil
+		for _, r := range results {
+			meta.ContributingProviders = append(meta.ContributingProviders, r.provider)
+		}
+		meta.RejectedProviders = nil
+	}
+
+	return uint64(median(survivors)), meta
+}
+
+// weightRepeats turns a provider's configured weight into an integer repeat
+// count so that higher-weighted providers count proportionally more towards
+// the aggregated median.
+func weightRepeats(weight float64) int {
+	n := int(weight + 0.5)
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func absFloat(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
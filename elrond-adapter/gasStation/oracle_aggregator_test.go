@@ -0,0 +1,129 @@
+package gasStation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-adapter/config"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingFetcher never returns on its own; it only unblocks when its context
+// is cancelled, mimicking an upstream that accepts a connection but never
+// responds.
+type blockingFetcher struct{ name string }
+
+func (f blockingFetcher) Name() string { return f.name }
+
+func (f blockingFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	<-ctx.Done()
+	return GasPriceGwei{}, ctx.Err()
+}
+
+type fakeFetcher struct {
+	name  string
+	price GasPriceGwei
+	err   error
+}
+
+func (f fakeFetcher) Name() string { return f.name }
+
+func (f fakeFetcher) FetchGasPrice(ctx context.Context) (GasPriceGwei, error) {
+	return f.price, f.err
+}
+
+func TestGasOracleAggregator_FetchGasPriceGweiRejectsOutliers(t *testing.T) {
+	t.Parallel()
+
+	aggregator := &GasOracleAggregator{
+		fetchers: []configuredFetcher{
+			{fetcher: fakeFetcher{name: "a", price: GasPriceGwei{Fast: 50, Standard: 40, Slow: 30}}, weight: 1, timeout: defaultProviderTimeout},
+			{fetcher: fakeFetcher{name: "b", price: GasPriceGwei{Fast: 52, Standard: 42, Slow: 31}}, weight: 1, timeout: defaultProviderTimeout},
+			{fetcher: fakeFetcher{name: "c", price: GasPriceGwei{Fast: 5000, Standard: 4000, Slow: 3000}}, weight: 1, timeout: defaultProviderTimeout},
+		},
+	}
+
+	gwei, metadata, err := aggregator.FetchGasPriceGwei()
+	require.Nil(t, err)
+	require.True(t, gwei.Fast == 51)
+	require.True(t, len(metadata.Fast.ContributingProviders) == 2)
+	require.Equal(t, []string{"c"}, metadata.Fast.RejectedProviders)
+}
+
+func TestGasOracleAggregator_FetchGasPriceGweiDegradesGracefully(t *testing.T) {
+	t.Parallel()
+
+	aggregator := &GasOracleAggregator{
+		fetchers: []configuredFetcher{
+			{fetcher: fakeFetcher{name: "a", err: errors.New("boom")}, weight: 1, timeout: defaultProviderTimeout},
+			{fetcher: fakeFetcher{name: "b", price: GasPriceGwei{Fast: 20, Standard: 15, Slow: 10}}, weight: 1, timeout: defaultProviderTimeout},
+		},
+	}
+
+	gwei, metadata, err := aggregator.FetchGasPriceGwei()
+	require.Nil(t, err)
+	require.True(t, gwei.Fast == 20)
+	require.Equal(t, []string{"a"}, metadata.Fast.RejectedProviders)
+}
+
+func TestConfiguredFetcher_FetchCancelsContextOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	cf := configuredFetcher{fetcher: blockingFetcher{name: "slow"}, weight: 1, timeout: 20 * time.Millisecond}
+
+	start := time.Now()
+	res := cf.fetch()
+	elapsed := time.Since(start)
+
+	require.Equal(t, errProviderTimedOut, res.err)
+	require.Less(t, elapsed, 500*time.Millisecond)
+}
+
+func TestNewGasOracleAggregator_WiresEnabledProvidersByDefault(t *testing.T) {
+	t.Parallel()
+
+	aggregator := NewGasOracleAggregator(config.GasConfig{
+		Providers: []config.GasProviderConfig{
+			{Name: "etherscan", APIKey: "key-a"},
+			{Name: "blocknative", APIKey: "key-b"},
+			{Name: "polygon"},
+		},
+	})
+
+	require.Len(t, aggregator.fetchers, 3)
+	var names []string
+	for _, cf := range aggregator.fetchers {
+		names = append(names, cf.fetcher.Name())
+	}
+	require.ElementsMatch(t, []string{"etherscan", "blocknative", "polygon"}, names)
+}
+
+func TestNewGasOracleAggregator_SkipsDisabledProviders(t *testing.T) {
+	t.Parallel()
+
+	aggregator := NewGasOracleAggregator(config.GasConfig{
+		Providers: []config.GasProviderConfig{
+			{Name: "etherscan", Disabled: true},
+			{Name: "polygon"},
+		},
+	})
+
+	require.Len(t, aggregator.fetchers, 1)
+	require.Equal(t, "polygon", aggregator.fetchers[0].fetcher.Name())
+}
+
+func TestNewGasOracleAggregator_FallsBackToJSONRPCWhenAllDisabled(t *testing.T) {
+	t.Parallel()
+
+	aggregator := NewGasOracleAggregator(config.GasConfig{
+		Providers: []config.GasProviderConfig{
+			{Name: "etherscan", Disabled: true},
+			{Name: "blocknative", Disabled: true},
+		},
+	})
+
+	require.Len(t, aggregator.fetchers, 1)
+	require.Equal(t, "jsonrpc", aggregator.fetchers[0].fetcher.Name())
+}